@@ -0,0 +1,191 @@
+package geojson
+
+import "math"
+
+// CutOptions configures CutAtAntimeridianWithOptions.
+type CutOptions struct {
+	// GreatCircle selects spherical (great-circle) interpolation of the latitude at which a
+	// segment crosses the antimeridian, instead of the default linear approximation.
+	GreatCircle bool
+}
+
+// CutAtAntimeridian splits g's LineString, MultiLineString, Polygon, or MultiPolygon coordinates
+// wherever they cross the antimeridian, as recommended by
+// [ยง3.1.9](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.9), using linear interpolation to
+// find the crossing latitude. A LineString that is split becomes a MultiLineString, and a Polygon
+// that is split becomes a MultiPolygon; other geometry types, and geometries that don't cross the
+// antimeridian, are returned unchanged.
+func CutAtAntimeridian(g Geometry) Geometry {
+	return CutAtAntimeridianWithOptions(g, CutOptions{})
+}
+
+// CutAtAntimeridianWithOptions is CutAtAntimeridian with control over the interpolation method
+// used to find each crossing's latitude.
+func CutAtAntimeridianWithOptions(g Geometry, opts CutOptions) Geometry {
+	switch t := g.(type) {
+	case *LineString:
+		chains := cutLineAtAntimeridian(t.Coordinates, opts.GreatCircle)
+		if len(chains) <= 1 {
+			return g
+		}
+		return NewMultiLineString(chains...)
+	case *MultiLineString:
+		var chains []LineStringCoordinates
+		split := false
+		for _, line := range t.Coordinates {
+			lineChains := cutLineAtAntimeridian(line, opts.GreatCircle)
+			split = split || len(lineChains) > 1
+			chains = append(chains, lineChains...)
+		}
+		if !split {
+			return g
+		}
+		return NewMultiLineString(chains...)
+	case *Polygon:
+		polygons, split := cutPolygonAtAntimeridian(t.Coordinates, opts.GreatCircle)
+		if !split {
+			return g
+		}
+		return NewMultiPolygon(polygons...)
+	case *MultiPolygon:
+		var polygons []PolygonCoordinates
+		split := false
+		for _, polygon := range t.Coordinates {
+			polygonPieces, polygonSplit := cutPolygonAtAntimeridian(polygon, opts.GreatCircle)
+			split = split || polygonSplit
+			polygons = append(polygons, polygonPieces...)
+		}
+		if !split {
+			return g
+		}
+		return NewMultiPolygon(polygons...)
+	default:
+		return g
+	}
+}
+
+// NormalizeLongitude returns pos with its longitude folded into [-180, 180].
+func NormalizeLongitude(pos Position) Position {
+	lon := math.Mod(pos.Longitude, 360)
+	if lon > 180 {
+		lon -= 360
+	} else if lon < -180 {
+		lon += 360
+	}
+	pos.Longitude = lon
+	return pos
+}
+
+// cutLineAtAntimeridian splits line into one or more chains wherever consecutive positions are
+// more than 180 degrees of longitude apart, inserting a pair of interpolated vertices at +180 and
+// -180 at each crossing. It returns a single-element slice containing line unchanged if there are
+// no crossings.
+func cutLineAtAntimeridian(line []Position, greatCircle bool) []LineStringCoordinates {
+	if len(line) == 0 {
+		return []LineStringCoordinates{line}
+	}
+	chains := []LineStringCoordinates{{line[0]}}
+	for i := 1; i < len(line); i++ {
+		p1, p2 := line[i-1], line[i]
+		if math.Abs(p2.Longitude-p1.Longitude) > 180 {
+			exitLon, enterLon := 180.0, -180.0
+			if p1.Longitude < 0 {
+				exitLon, enterLon = -180.0, 180.0
+			}
+			var lat float64
+			if greatCircle {
+				lat = greatCircleLatitudeAtLongitude(p1, p2, exitLon)
+			} else {
+				lat = linearCrossingLatitude(p1, p2)
+			}
+			current := chains[len(chains)-1]
+			chains[len(chains)-1] = append(current, NewPosition2D(exitLon, lat))
+			chains = append(chains, LineStringCoordinates{NewPosition2D(enterLon, lat)})
+		}
+		last := chains[len(chains)-1]
+		chains[len(chains)-1] = append(last, p2)
+	}
+	return chains
+}
+
+// cutRingAtAntimeridian is cutLineAtAntimeridian for a closed LinearRing: since ring's first and
+// last positions already coincide, the chain that starts at ring[0] and the chain that ends at
+// ring[len(ring)-1] are the same arc split by an arbitrary starting point, so they're rejoined.
+func cutRingAtAntimeridian(ring LinearRing, greatCircle bool) []LineStringCoordinates {
+	chains := cutLineAtAntimeridian(ring, greatCircle)
+	if len(chains) <= 1 {
+		return chains
+	}
+	first, last := chains[0], chains[len(chains)-1]
+	rejoined := append(append(LineStringCoordinates{}, last...), first[1:]...)
+	return append([]LineStringCoordinates{rejoined}, chains[1:len(chains)-1]...)
+}
+
+// closeAntimeridianChains closes each chain produced by cutRingAtAntimeridian into a LinearRing.
+// Every chain's two endpoints lie on the same antimeridian longitude (a consequence of crossings
+// alternating direction around a simple ring), so closing it is a single straight edge along that
+// meridian.
+func closeAntimeridianChains(chains []LineStringCoordinates) []LinearRing {
+	rings := make([]LinearRing, len(chains))
+	for i, chain := range chains {
+		rings[i] = append(append(LinearRing{}, chain...), chain[0])
+	}
+	return rings
+}
+
+// cutPolygonAtAntimeridian splits rings' exterior boundary at the antimeridian and distributes its
+// holes (splitting any hole that also crosses) to whichever resulting exterior ring contains them.
+// It reports whether a split occurred.
+func cutPolygonAtAntimeridian(rings PolygonCoordinates, greatCircle bool) ([]PolygonCoordinates, bool) {
+	if len(rings) == 0 {
+		return []PolygonCoordinates{rings}, false
+	}
+	exteriorChains := cutRingAtAntimeridian(rings[0], greatCircle)
+	if len(exteriorChains) <= 1 {
+		return []PolygonCoordinates{rings}, false
+	}
+	exteriorRings := closeAntimeridianChains(exteriorChains)
+	polygons := make([]PolygonCoordinates, len(exteriorRings))
+	for i, ring := range exteriorRings {
+		polygons[i] = PolygonCoordinates{ring}
+	}
+	for _, hole := range rings[1:] {
+		holeRings := []LinearRing{hole}
+		if holeChains := cutRingAtAntimeridian(hole, greatCircle); len(holeChains) > 1 {
+			holeRings = closeAntimeridianChains(holeChains)
+		}
+		for _, holeRing := range holeRings {
+			for i, ext := range exteriorRings {
+				// A split piece's first vertex lies on the antimeridian, i.e. on ext's boundary, so
+				// containment is tested with the vertex after it.
+				if pointInRing(holeRing[1], ext) {
+					polygons[i] = append(polygons[i], holeRing)
+					break
+				}
+			}
+		}
+	}
+	return polygons, true
+}
+
+// linearCrossingLatitude approximates the latitude at which the segment p1p2 crosses the
+// antimeridian by linear interpolation, as described in
+// [ยง3.1.9](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.9). p1 and p2 are assumed to be more
+// than 180 degrees of longitude apart (i.e. a genuine crossing, per cutLineAtAntimeridian's own
+// threshold), so the short way around is 360 minus that raw difference, not the raw difference
+// itself.
+func linearCrossingLatitude(p1, p2 Position) float64 {
+	shortWayDistance := 360 - math.Abs(p2.Longitude-p1.Longitude)
+	return p1.Latitude + (p2.Latitude-p1.Latitude)*(180-math.Abs(p1.Longitude))/shortWayDistance
+}
+
+// greatCircleLatitudeAtLongitude returns the latitude at which the great circle through p1 and p2
+// crosses longitude lon, using the standard great-circle intersection formula.
+func greatCircleLatitudeAtLongitude(p1, p2 Position, lon float64) float64 {
+	lat1, lon1 := p1.Latitude*math.Pi/180, p1.Longitude*math.Pi/180
+	lat2, lon2 := p2.Latitude*math.Pi/180, p2.Longitude*math.Pi/180
+	lon3 := lon * math.Pi / 180
+	num := math.Sin(lat1)*math.Cos(lat2)*math.Sin(lon3-lon2) - math.Sin(lat2)*math.Cos(lat1)*math.Sin(lon3-lon1)
+	den := math.Cos(lat1) * math.Cos(lat2) * math.Sin(lon1-lon2)
+	return math.Atan(num/den) * 180 / math.Pi
+}
@@ -0,0 +1,115 @@
+package geojson
+
+import "testing"
+
+func TestNormalizeLongitude(t *testing.T) {
+	cases := []struct{ in, want float64 }{
+		{0, 0}, {180, 180}, {-180, -180}, {181, -179}, {-181, 179}, {540, 180}, {-540, -180},
+	}
+	for _, c := range cases {
+		got := NormalizeLongitude(NewPosition2D(c.in, 0)).Longitude
+		if got != c.want {
+			t.Errorf("NormalizeLongitude(%g) = %g, want %g", c.in, got, c.want)
+		}
+	}
+}
+
+func TestCutAtAntimeridianLineString(t *testing.T) {
+	ls := NewLineString(NewPosition2D(170, 10), NewPosition2D(-170, 20))
+	cut := CutAtAntimeridian(ls)
+	mls, ok := cut.(*MultiLineString)
+	if !ok {
+		t.Fatalf("expected *MultiLineString, got %T", cut)
+	}
+	if len(mls.Coordinates) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(mls.Coordinates))
+	}
+	first := mls.Coordinates[0]
+	if last := first[len(first)-1]; last.Longitude != 180 {
+		t.Errorf("expected first chain to end at lon 180, got %g", last.Longitude)
+	}
+	if second := mls.Coordinates[1]; second[0].Longitude != -180 {
+		t.Errorf("expected second chain to start at lon -180, got %g", second[0].Longitude)
+	}
+}
+
+func TestLinearCrossingLatitudeIsShortWayInterpolation(t *testing.T) {
+	// By symmetry (lon=170,lat=0) -> (lon=-170,lat=10) crosses the antimeridian exactly halfway
+	// between the two latitudes.
+	got := linearCrossingLatitude(NewPosition2D(170, 0), NewPosition2D(-170, 10))
+	if want := 5.0; got != want {
+		t.Fatalf("linearCrossingLatitude() = %g, want %g", got, want)
+	}
+}
+
+func TestCutAtAntimeridianLeavesNonCrossingGeometryUnchanged(t *testing.T) {
+	plain := NewLineString(NewPosition2D(10, 10), NewPosition2D(20, 20))
+	if cut := CutAtAntimeridian(plain); cut != Geometry(plain) {
+		t.Fatalf("expected unchanged geometry to be returned as-is, got %#v", cut)
+	}
+}
+
+func TestCutAtAntimeridianMultiLineString(t *testing.T) {
+	mls := NewMultiLineString(
+		NewLineStringCoordinates(NewPosition2D(170, 10), NewPosition2D(-170, 20)),
+		NewLineStringCoordinates(NewPosition2D(0, 0), NewPosition2D(1, 1)),
+	)
+	cut := CutAtAntimeridian(mls).(*MultiLineString)
+	if len(cut.Coordinates) != 3 {
+		t.Fatalf("expected the crossing line split into 2 plus the untouched line, got %d lines", len(cut.Coordinates))
+	}
+}
+
+func TestCutAtAntimeridianPolygon(t *testing.T) {
+	ring := NewLinearRing(
+		NewPosition2D(170, -10), NewPosition2D(-170, -10),
+		NewPosition2D(-170, 10), NewPosition2D(170, 10),
+		NewPosition2D(170, -10),
+	)
+	cut := CutAtAntimeridian(NewPolygon(ring))
+	mp, ok := cut.(*MultiPolygon)
+	if !ok {
+		t.Fatalf("expected *MultiPolygon, got %T", cut)
+	}
+	if len(mp.Coordinates) != 2 {
+		t.Fatalf("expected 2 polygons, got %d", len(mp.Coordinates))
+	}
+	if err := mp.Validate(); err != nil {
+		t.Errorf("split polygon is invalid: %v", err)
+	}
+}
+
+func TestCutAtAntimeridianPolygonDistributesHole(t *testing.T) {
+	exterior := NewLinearRing(
+		NewPosition2D(170, -10), NewPosition2D(-170, -10),
+		NewPosition2D(-170, 10), NewPosition2D(170, 10),
+		NewPosition2D(170, -10),
+	)
+	// A small hole entirely on the east (negative-longitude) side of the split.
+	hole := NewLinearRing(
+		NewPosition2D(-175, -2), NewPosition2D(-175, 2), NewPosition2D(-172, 2), NewPosition2D(-172, -2), NewPosition2D(-175, -2),
+	)
+	cut := CutAtAntimeridian(NewPolygon(exterior, hole)).(*MultiPolygon)
+	if len(cut.Coordinates) != 2 {
+		t.Fatalf("expected 2 polygons, got %d", len(cut.Coordinates))
+	}
+	holes := 0
+	for _, polygon := range cut.Coordinates {
+		holes += len(polygon) - 1
+	}
+	if holes != 1 {
+		t.Fatalf("expected the hole to be assigned to exactly one split polygon, got %d", holes)
+	}
+}
+
+func TestCutAtAntimeridianWithOptionsGreatCircle(t *testing.T) {
+	ls := NewLineString(NewPosition2D(170, 10), NewPosition2D(-170, 20))
+	cut := CutAtAntimeridianWithOptions(ls, CutOptions{GreatCircle: true})
+	mls, ok := cut.(*MultiLineString)
+	if !ok {
+		t.Fatalf("expected *MultiLineString, got %T", cut)
+	}
+	if len(mls.Coordinates) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(mls.Coordinates))
+	}
+}
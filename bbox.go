@@ -0,0 +1,264 @@
+package geojson
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// BoundingBox represents a [GeoJSON bounding box](https://www.rfc-editor.org/rfc/rfc7946#section-5):
+// the south-westerly most position, followed by the north-easterly most position.
+//
+// Per [ยง5.2](https://www.rfc-editor.org/rfc/rfc7946#section-5.2), a BoundingBox whose first
+// longitude is greater than its second spans the antimeridian.
+type BoundingBox [2]Position
+
+// MarshalJSON implements json.Marshaler, emitting the flat `[w,s,e,n]` (or `[w,s,minAlt,e,n,maxAlt]`)
+// array required by [ยง5](https://www.rfc-editor.org/rfc/rfc7946#section-5).
+func (b BoundingBox) MarshalJSON() ([]byte, error) {
+	if b[0].Altitude != nil && b[1].Altitude != nil {
+		return json.Marshal([]float64{b[0].Longitude, b[0].Latitude, *b[0].Altitude, b[1].Longitude, b[1].Latitude, *b[1].Altitude})
+	}
+	return json.Marshal([]float64{b[0].Longitude, b[0].Latitude, b[1].Longitude, b[1].Latitude})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BoundingBox) UnmarshalJSON(data []byte) error {
+	var raw []float64
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	switch len(raw) {
+	case 4:
+		b[0] = NewPosition2D(raw[0], raw[1])
+		b[1] = NewPosition2D(raw[2], raw[3])
+	case 6:
+		b[0] = NewPosition3D(raw[0], raw[1], raw[2])
+		b[1] = NewPosition3D(raw[3], raw[4], raw[5])
+	default:
+		return &CoordinatesError{Type: "BoundingBox", Reason: fmt.Sprintf("expected 4 or 6 elements, got %d", len(raw))}
+	}
+	return nil
+}
+
+// collectPositions flattens all of a geometry's coordinates into a single slice of Position,
+// recursing into GeometryCollection elements.
+func collectPositions(g Geometry) []Position {
+	switch t := g.(type) {
+	case *Point:
+		return []Position{t.Coordinates}
+	case *MultiPoint:
+		return t.Coordinates
+	case *LineString:
+		return t.Coordinates
+	case *MultiLineString:
+		var positions []Position
+		for _, line := range t.Coordinates {
+			positions = append(positions, line...)
+		}
+		return positions
+	case *Polygon:
+		var positions []Position
+		for _, ring := range t.Coordinates {
+			positions = append(positions, ring...)
+		}
+		return positions
+	case *MultiPolygon:
+		var positions []Position
+		for _, polygon := range t.Coordinates {
+			for _, ring := range polygon {
+				positions = append(positions, ring...)
+			}
+		}
+		return positions
+	case *GeometryCollection:
+		var positions []Position
+		for _, geometry := range t.Geometries {
+			positions = append(positions, collectPositions(geometry)...)
+		}
+		return positions
+	default:
+		return nil
+	}
+}
+
+// longitudeEnvelope returns the plain [min, max] longitude range enclosing lons. A geometry's
+// coordinates are flat-plane numbers, not points on a globe, so a wide longitude spread doesn't by
+// itself mean the geometry was meant to wrap around the antimeridian the "short way" — inferring
+// that from point spread alone misclassifies ordinary wide geometries (e.g. a LineString from
+// lon -30 to lon 170) as antimeridian-spanning. Geometries that are actually meant to wrap should
+// be split first, e.g. with CutAtAntimeridian, whose pieces each have an ordinary, non-wrapping
+// envelope.
+func longitudeEnvelope(lons []float64) (min, max float64) {
+	min, max = lons[0], lons[0]
+	for _, lon := range lons[1:] {
+		if lon < min {
+			min = lon
+		}
+		if lon > max {
+			max = lon
+		}
+	}
+	return min, max
+}
+
+// envelope computes the BoundingBox enclosing positions, or nil if positions is empty.
+func envelope(positions []Position) *BoundingBox {
+	if len(positions) == 0 {
+		return nil
+	}
+	lons := make([]float64, len(positions))
+	latMin, latMax := positions[0].Latitude, positions[0].Latitude
+	var altMin, altMax *float64
+	for i, p := range positions {
+		lons[i] = p.Longitude
+		if p.Latitude < latMin {
+			latMin = p.Latitude
+		}
+		if p.Latitude > latMax {
+			latMax = p.Latitude
+		}
+		if p.Altitude != nil {
+			if altMin == nil || *p.Altitude < *altMin {
+				v := *p.Altitude
+				altMin = &v
+			}
+			if altMax == nil || *p.Altitude > *altMax {
+				v := *p.Altitude
+				altMax = &v
+			}
+		}
+	}
+	lonMin, lonMax := longitudeEnvelope(lons)
+	return &BoundingBox{
+		Position{Longitude: lonMin, Latitude: latMin, Altitude: altMin},
+		Position{Longitude: lonMax, Latitude: latMax, Altitude: altMax},
+	}
+}
+
+// BBox computes the envelope of g's coordinates, implementing interface Object. If g was
+// unmarshaled from text that included a "bbox" member, that parsed value is returned instead.
+func (g *Point) BBox() *BoundingBox {
+	if g.parsedBBox != nil {
+		return g.parsedBBox
+	}
+	return envelope(collectPositions(g))
+}
+
+// WithBBox configures g to include a computed "bbox" member when marshaled, as described in
+// [ยง5 Bounding Box](https://www.rfc-editor.org/rfc/rfc7946#section-5).
+func (g *Point) WithBBox() *Point {
+	g.includeBBox = true
+	return g
+}
+
+// BBox computes the envelope of g's coordinates, implementing interface Object. If g was
+// unmarshaled from text that included a "bbox" member, that parsed value is returned instead.
+func (g *MultiPoint) BBox() *BoundingBox {
+	if g.parsedBBox != nil {
+		return g.parsedBBox
+	}
+	return envelope(collectPositions(g))
+}
+
+// WithBBox configures g to include a computed "bbox" member when marshaled, as described in
+// [ยง5 Bounding Box](https://www.rfc-editor.org/rfc/rfc7946#section-5).
+func (g *MultiPoint) WithBBox() *MultiPoint {
+	g.includeBBox = true
+	return g
+}
+
+// BBox computes the envelope of g's coordinates, implementing interface Object. If g was
+// unmarshaled from text that included a "bbox" member, that parsed value is returned instead.
+func (g *LineString) BBox() *BoundingBox {
+	if g.parsedBBox != nil {
+		return g.parsedBBox
+	}
+	return envelope(collectPositions(g))
+}
+
+// WithBBox configures g to include a computed "bbox" member when marshaled, as described in
+// [ยง5 Bounding Box](https://www.rfc-editor.org/rfc/rfc7946#section-5).
+func (g *LineString) WithBBox() *LineString {
+	g.includeBBox = true
+	return g
+}
+
+// BBox computes the envelope of g's coordinates, implementing interface Object. If g was
+// unmarshaled from text that included a "bbox" member, that parsed value is returned instead.
+func (g *MultiLineString) BBox() *BoundingBox {
+	if g.parsedBBox != nil {
+		return g.parsedBBox
+	}
+	return envelope(collectPositions(g))
+}
+
+// WithBBox configures g to include a computed "bbox" member when marshaled, as described in
+// [ยง5 Bounding Box](https://www.rfc-editor.org/rfc/rfc7946#section-5).
+func (g *MultiLineString) WithBBox() *MultiLineString {
+	g.includeBBox = true
+	return g
+}
+
+// BBox computes the envelope of g's coordinates, implementing interface Object. If g was
+// unmarshaled from text that included a "bbox" member, that parsed value is returned instead.
+func (g *Polygon) BBox() *BoundingBox {
+	if g.parsedBBox != nil {
+		return g.parsedBBox
+	}
+	return envelope(collectPositions(g))
+}
+
+// WithBBox configures g to include a computed "bbox" member when marshaled, as described in
+// [ยง5 Bounding Box](https://www.rfc-editor.org/rfc/rfc7946#section-5).
+func (g *Polygon) WithBBox() *Polygon {
+	g.includeBBox = true
+	return g
+}
+
+// BBox computes the envelope of g's coordinates, implementing interface Object. If g was
+// unmarshaled from text that included a "bbox" member, that parsed value is returned instead.
+func (g *MultiPolygon) BBox() *BoundingBox {
+	if g.parsedBBox != nil {
+		return g.parsedBBox
+	}
+	return envelope(collectPositions(g))
+}
+
+// WithBBox configures g to include a computed "bbox" member when marshaled, as described in
+// [ยง5 Bounding Box](https://www.rfc-editor.org/rfc/rfc7946#section-5).
+func (g *MultiPolygon) WithBBox() *MultiPolygon {
+	g.includeBBox = true
+	return g
+}
+
+// BBox computes the envelope of g's Geometries, recursing into nested GeometryCollections,
+// implementing interface Object. If g was unmarshaled from text that included a "bbox" member,
+// that parsed value is returned instead.
+func (g *GeometryCollection) BBox() *BoundingBox {
+	if g.parsedBBox != nil {
+		return g.parsedBBox
+	}
+	return envelope(collectPositions(g))
+}
+
+// WithBBox configures g to include a computed "bbox" member when marshaled, as described in
+// [ยง5 Bounding Box](https://www.rfc-editor.org/rfc/rfc7946#section-5).
+func (g *GeometryCollection) WithBBox() *GeometryCollection {
+	g.includeBBox = true
+	return g
+}
+
+// BBox computes the envelope of every Feature's geometry in c, implementing interface Object. If
+// c was unmarshaled from text that included a "bbox" member, that parsed value is returned instead.
+func (c FeatureCollection) BBox() *BoundingBox {
+	if c.parsedBBox != nil {
+		return c.parsedBBox
+	}
+	var positions []Position
+	for _, f := range c.Features {
+		if f.Geometry != nil {
+			positions = append(positions, collectPositions(f.Geometry)...)
+		}
+	}
+	return envelope(positions)
+}
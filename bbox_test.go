@@ -0,0 +1,76 @@
+package geojson
+
+import "testing"
+
+func TestBBoxPlainEnvelope(t *testing.T) {
+	bbox := NewLineString(NewPosition2D(0, 0), NewPosition2D(10, 20)).BBox()
+	if bbox[0].Longitude != 0 || bbox[0].Latitude != 0 || bbox[1].Longitude != 10 || bbox[1].Latitude != 20 {
+		t.Fatalf("got %#v, want [(0,0),(10,20)]", bbox)
+	}
+}
+
+// A wide but ordinary LineString, never passed through CutAtAntimeridian, must not be
+// misclassified as spanning the antimeridian: its bbox should enclose every coordinate, not just
+// the short arc through +/-180.
+func TestBBoxDoesNotInferAntimeridianFromWidePoints(t *testing.T) {
+	bbox := NewLineString(NewPosition2D(-30, 0), NewPosition2D(170, 0)).BBox()
+	if bbox[0].Longitude > bbox[1].Longitude {
+		t.Fatalf("got wraparound bbox %#v for an ordinary (non-antimeridian-crossing) LineString", bbox)
+	}
+	if bbox[0].Longitude != -30 || bbox[1].Longitude != 170 {
+		t.Fatalf("got %#v, want MinLon -30, MaxLon 170", bbox)
+	}
+}
+
+func TestBBoxMultiPointDoesNotInferAntimeridianFromSpread(t *testing.T) {
+	bbox := NewMultiPoint(NewPosition2D(179, 0), NewPosition2D(-179, 0)).BBox()
+	if bbox[0].Longitude > bbox[1].Longitude {
+		t.Fatalf("got wraparound bbox %#v for scattered MultiPoint positions with no connecting edge", bbox)
+	}
+}
+
+func TestPointWithBBoxChaining(t *testing.T) {
+	data, err := ToText(NewPoint(NewPosition2D(1, 2)).WithBBox())
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if got := string(data); !contains(got, `"bbox"`) {
+		t.Fatalf("expected a bbox member, got %s", got)
+	}
+}
+
+func TestFeatureWithBBoxChains(t *testing.T) {
+	// NewFeature returns a Feature value, not a pointer, so WithBBox must be callable directly on
+	// it without an intermediate variable.
+	data, err := ToText(NewFeature(NewPoint(NewPosition2D(1, 2)), nil).WithBBox())
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if got := string(data); !contains(got, `"bbox"`) {
+		t.Fatalf("expected a bbox member, got %s", got)
+	}
+}
+
+func TestFeatureCollectionWithBBoxChains(t *testing.T) {
+	// NewFeatureCollection returns a FeatureCollection value, not a pointer, so WithBBox must be
+	// callable directly on it without an intermediate variable.
+	fc := NewFeatureCollection(NewFeature(NewPoint(NewPosition2D(1, 2)), nil)).WithBBox()
+	data, err := ToText(&fc)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if got := string(data); !contains(got, `"bbox"`) {
+		t.Fatalf("expected a bbox member, got %s", got)
+	}
+}
+
+func contains(s, substr string) bool {
+	return len(s) >= len(substr) && (func() bool {
+		for i := 0; i+len(substr) <= len(s); i++ {
+			if s[i:i+len(substr)] == substr {
+				return true
+			}
+		}
+		return false
+	})()
+}
@@ -0,0 +1,58 @@
+package geojson
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// reservedForeignMemberKeys are the top-level member names the GeoJSON spec assigns meaning to on
+// a Feature or FeatureCollection; ForeignMembers may not use them.
+var reservedForeignMemberKeys = map[string]bool{
+	"type":       true,
+	"geometry":   true,
+	"properties": true,
+	"features":   true,
+	"id":         true,
+	"bbox":       true,
+}
+
+// ForeignMemberKeyError is returned when a ForeignMembers map uses a key reserved by
+// [ยง6.1 Foreign Members](https://www.rfc-editor.org/rfc/rfc7946#section-6.1).
+type ForeignMemberKeyError struct {
+	Key string
+}
+
+func (e *ForeignMemberKeyError) Error() string {
+	return fmt.Sprintf("geojson: foreign member key %q is reserved", e.Key)
+}
+
+// mergeForeignMembers copies foreign's entries into base, rejecting any reserved key.
+func mergeForeignMembers(base map[string]any, foreign map[string]any) (map[string]any, error) {
+	for key, value := range foreign {
+		if reservedForeignMemberKeys[key] {
+			return nil, &ForeignMemberKeyError{Key: key}
+		}
+		base[key] = value
+	}
+	return base, nil
+}
+
+// extractForeignMembers decodes every member of raw that isn't one of the reserved keys into a
+// ForeignMembers map, or nil if there are none.
+func extractForeignMembers(raw map[string]json.RawMessage) (map[string]any, error) {
+	var foreign map[string]any
+	for key, value := range raw {
+		if reservedForeignMemberKeys[key] {
+			continue
+		}
+		var decoded any
+		if err := json.Unmarshal(value, &decoded); err != nil {
+			return nil, err
+		}
+		if foreign == nil {
+			foreign = make(map[string]any)
+		}
+		foreign[key] = decoded
+	}
+	return foreign, nil
+}
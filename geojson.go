@@ -20,7 +20,9 @@ type Object interface {
 	// which emphasises that other types are not allowed.
 	Type() string
 
-	// // BBox() *BoundingBox
+	// BBox computes the envelope of this object's coordinates, as described in
+	// [ยง5 Bounding Box](https://www.rfc-editor.org/rfc/rfc7946#section-5), or nil if it has none.
+	BBox() *BoundingBox
 }
 
 var _ Object = (Geometry)(nil)
@@ -35,12 +37,39 @@ func ToText(o Object) ([]byte, error) {
 // Position represents a [GeoJSON position](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.1).
 type Position struct {
 	Longitude, Latitude float64
-	// Altitude           *float64
+	Altitude            *float64
 }
 
-// MarshalJSON implements json.Marshaler.
+// NewPosition2D creates a new Position with no altitude.
+func NewPosition2D(longitude, latitude float64) Position {
+	return Position{Longitude: longitude, Latitude: latitude}
+}
+
+// NewPosition3D creates a new Position with the given altitude.
+func NewPosition3D(longitude, latitude, altitude float64) Position {
+	return Position{Longitude: longitude, Latitude: latitude, Altitude: &altitude}
+}
+
+// Equal reports whether p and other represent the same position, comparing altitudes by value
+// rather than by pointer.
+func (p Position) Equal(other Position) bool {
+	if p.Longitude != other.Longitude || p.Latitude != other.Latitude {
+		return false
+	}
+	if (p.Altitude == nil) != (other.Altitude == nil) {
+		return false
+	}
+	return p.Altitude == nil || *p.Altitude == *other.Altitude
+}
+
+// MarshalJSON implements json.Marshaler, emitting `[lon,lat]` when Altitude is nil and
+// `[lon,lat,alt]` otherwise.
 func (p Position) MarshalJSON() ([]byte, error) {
-	return []byte(fmt.Sprintf("[%f,%f]", p.Longitude, p.Latitude)), nil
+	coordinates := []float64{p.Longitude, p.Latitude}
+	if p.Altitude != nil {
+		coordinates = append(coordinates, *p.Altitude)
+	}
+	return json.Marshal(coordinates)
 }
 
 // Geometry represents a [GeoJSON Geometry](https://www.rfc-editor.org/rfc/rfc7946#section-3.1).
@@ -48,6 +77,11 @@ type Geometry interface {
 	Object
 	ToFeature(properties map[string]any) Feature
 	// Coordinates() []any
+
+	// Validate checks this geometry against the constraints of
+	// [ยง3.1 Geometry Objects](https://www.rfc-editor.org/rfc/rfc7946#section-3.1), returning a
+	// *ValidationError describing the first violation found, if any.
+	Validate() error
 }
 
 var _ Geometry = (*Point)(nil)
@@ -60,6 +94,8 @@ var _ Geometry = (*GeometryCollection)(nil)
 
 type geometryWithCoordinates[C any] struct {
 	Coordinates C `json:"coordinates"`
+	includeBBox bool
+	parsedBBox  *BoundingBox
 }
 
 // LineStringCoordinates represents the coordinates of a LineString, and one of the elements in the coordinates of a MultiLineString.
@@ -78,12 +114,23 @@ type LinearRing []Position
 func NewLinearRing(c0, c1, c2, c3 Position, cn ...Position) LinearRing {
 	coordinates := make(LinearRing, 0, 4+len(cn))
 	coordinates = append(append(coordinates, c0, c1, c2, c3), cn...)
-	if coordinates[len(coordinates)-1] != c0 {
+	if !coordinates[len(coordinates)-1].Equal(c0) {
 		panic(fmt.Sprintf("start position %#v doesn't match end position %#v", c0, coordinates[len(coordinates)-1]))
 	}
 	return coordinates
 }
 
+// NewLinearRingE creates a new LinearRing with the given positions, returning an *UnclosedRingError
+// instead of panicking if the first and last positions don't match.
+func NewLinearRingE(c0, c1, c2, c3 Position, cn ...Position) (LinearRing, error) {
+	coordinates := make(LinearRing, 0, 4+len(cn))
+	coordinates = append(append(coordinates, c0, c1, c2, c3), cn...)
+	if !coordinates[len(coordinates)-1].Equal(c0) {
+		return nil, &UnclosedRingError{First: c0, Last: coordinates[len(coordinates)-1]}
+	}
+	return coordinates, nil
+}
+
 // PolygonCoordinates represents the coordinates of a Polygon, and one of the elements in the coordinates of a MultiPolygon.
 type PolygonCoordinates []LinearRing
 
@@ -98,15 +145,21 @@ type Point geometryWithCoordinates[Position]
 
 // NewPoint creates a new Point with the given position.
 func NewPoint(p Position) *Point {
-	return &Point{p}
+	return &Point{Coordinates: p}
 }
 
-func marshalGeoJSONGeometryWithCoordinates[C any](g Geometry, c C) ([]byte, error) {
+func marshalGeoJSONGeometryWithCoordinates[C any](g Geometry, c C, includeBBox bool) ([]byte, error) {
+	var bbox *BoundingBox
+	if includeBBox {
+		bbox = g.BBox()
+	}
 	return json.Marshal(struct {
-		Type        string `json:"type"`
-		Coordinates C      `json:"coordinates"`
+		Type        string       `json:"type"`
+		BBox        *BoundingBox `json:"bbox,omitempty"`
+		Coordinates C            `json:"coordinates"`
 	}{
 		Type:        g.Type(),
+		BBox:        bbox,
 		Coordinates: c,
 	})
 }
@@ -117,11 +170,11 @@ func (g *Point) Type() string {
 }
 
 // MarhsalJSON implements json.Marshaler.
-func (g *Point) ToFeature(properties map[string]any) Feature { return Feature{g, properties} }
+func (g *Point) ToFeature(properties map[string]any) Feature { return Feature{Geometry: g, Properties: properties} }
 
 // MarshalJSON implements json.Marshaler.
 func (g *Point) MarshalJSON() ([]byte, error) {
-	return marshalGeoJSONGeometryWithCoordinates(g, g.Coordinates)
+	return marshalGeoJSONGeometryWithCoordinates(g, g.Coordinates, g.includeBBox)
 }
 
 // MultiPoint represents a [GeoJSON MultiPoint](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.3).
@@ -129,18 +182,18 @@ type MultiPoint geometryWithCoordinates[[]Position]
 
 // NewMultiPoint creates a new MultiPoint with the given position.
 func NewMultiPoint(pn ...Position) *MultiPoint {
-	return &MultiPoint{pn}
+	return &MultiPoint{Coordinates: pn}
 }
 
 // Type returns "MultiPoint", implementing interface Object.
 func (g *MultiPoint) Type() string {
 	return "MultiPoint"
 }
-func (g *MultiPoint) ToFeature(properties map[string]any) Feature { return Feature{g, properties} }
+func (g *MultiPoint) ToFeature(properties map[string]any) Feature { return Feature{Geometry: g, Properties: properties} }
 
 // MarshalJSON implements json.Marshaler.
 func (g *MultiPoint) MarshalJSON() ([]byte, error) {
-	return marshalGeoJSONGeometryWithCoordinates(g, g.Coordinates)
+	return marshalGeoJSONGeometryWithCoordinates(g, g.Coordinates, g.includeBBox)
 }
 
 // LineString represents a [GeoJSON LineString](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.4).
@@ -148,18 +201,18 @@ type LineString geometryWithCoordinates[LineStringCoordinates]
 
 // NewLineString creates a new LineString with the given positions.
 func NewLineString(c0, c1 Position, cn ...Position) *LineString {
-	return &LineString{NewLineStringCoordinates(c0, c1, cn...)}
+	return &LineString{Coordinates: NewLineStringCoordinates(c0, c1, cn...)}
 }
 
 // Type returns "LineString", implementing interface Object.
 func (g *LineString) Type() string {
 	return "LineString"
 }
-func (g *LineString) ToFeature(properties map[string]any) Feature { return Feature{g, properties} }
+func (g *LineString) ToFeature(properties map[string]any) Feature { return Feature{Geometry: g, Properties: properties} }
 
 // MarshalJSON implements json.Marshaler.
 func (g *LineString) MarshalJSON() ([]byte, error) {
-	return marshalGeoJSONGeometryWithCoordinates(g, g.Coordinates)
+	return marshalGeoJSONGeometryWithCoordinates(g, g.Coordinates, g.includeBBox)
 }
 
 // MultiLineString represents a [GeoJSON MultiLineString](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.5).
@@ -167,18 +220,18 @@ type MultiLineString geometryWithCoordinates[[]LineStringCoordinates]
 
 // NewMultiLineString creates a new MultiLineString with the given LineStringCoordinates.
 func NewMultiLineString(coordinates ...LineStringCoordinates) *MultiLineString {
-	return &MultiLineString{coordinates}
+	return &MultiLineString{Coordinates: coordinates}
 }
 
 // Type returns "MultiLineString", implementing interface Object.
 func (g *MultiLineString) Type() string {
 	return "MultiLineString"
 }
-func (g *MultiLineString) ToFeature(properties map[string]any) Feature { return Feature{g, properties} }
+func (g *MultiLineString) ToFeature(properties map[string]any) Feature { return Feature{Geometry: g, Properties: properties} }
 
 // MarshalJSON implements json.Marshaler.
 func (g *MultiLineString) MarshalJSON() ([]byte, error) {
-	return marshalGeoJSONGeometryWithCoordinates(g, g.Coordinates)
+	return marshalGeoJSONGeometryWithCoordinates(g, g.Coordinates, g.includeBBox)
 }
 
 // Polygon represents a [GeoJSON Polygon](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.6).
@@ -186,18 +239,18 @@ type Polygon geometryWithCoordinates[PolygonCoordinates]
 
 // NewPolygon creates a new Polygon with the given linear rings.
 func NewPolygon(outerBoundary LinearRing, holes ...LinearRing) *Polygon {
-	return &Polygon{NewPolygonCoordinates(outerBoundary, holes...)}
+	return &Polygon{Coordinates: NewPolygonCoordinates(outerBoundary, holes...)}
 }
 
 // Type returns "Polygon", implementing interface Object.
 func (g *Polygon) Type() string {
 	return "Polygon"
 }
-func (g *Polygon) ToFeature(properties map[string]any) Feature { return Feature{g, properties} }
+func (g *Polygon) ToFeature(properties map[string]any) Feature { return Feature{Geometry: g, Properties: properties} }
 
 // MarshalJSON implements json.Marshaler.
 func (g *Polygon) MarshalJSON() ([]byte, error) {
-	return marshalGeoJSONGeometryWithCoordinates(g, g.Coordinates)
+	return marshalGeoJSONGeometryWithCoordinates(g, g.Coordinates, g.includeBBox)
 }
 
 // MultiPolygon represents a [GeoJSON MultiPolygon](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.7).
@@ -205,26 +258,30 @@ type MultiPolygon geometryWithCoordinates[[]PolygonCoordinates]
 
 // NewMultiPolygon creates a new MultiPolygon from the given PolygoneCoordinates.
 func NewMultiPolygon(coordinates ...PolygonCoordinates) *MultiPolygon {
-	return &MultiPolygon{coordinates}
+	return &MultiPolygon{Coordinates: coordinates}
 }
 
 // Type returns "MultiPolygon", implementing interface Object.
 func (g *MultiPolygon) Type() string {
 	return "MultiPolygon"
 }
-func (g *MultiPolygon) ToFeature(properties map[string]any) Feature { return Feature{g, properties} }
+func (g *MultiPolygon) ToFeature(properties map[string]any) Feature { return Feature{Geometry: g, Properties: properties} }
 
 // MarshalJSON implements json.Marshaler.
 func (g *MultiPolygon) MarshalJSON() ([]byte, error) {
-	return marshalGeoJSONGeometryWithCoordinates(g, g.Coordinates)
+	return marshalGeoJSONGeometryWithCoordinates(g, g.Coordinates, g.includeBBox)
 }
 
 // GeometryCollection represents a [GeoJSON GeometryCollection](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.8).
-type GeometryCollection []Geometry
+type GeometryCollection struct {
+	Geometries  []Geometry
+	includeBBox bool
+	parsedBBox  *BoundingBox
+}
 
 // NewGeometryCollection creates a new GeometryCollection from the given Geomtries.
 func NewGeometryCollection(geometries ...Geometry) *GeometryCollection {
-	return (*GeometryCollection)(&geometries)
+	return &GeometryCollection{Geometries: geometries}
 }
 
 // Type returns "GeometryCollection", implementing interface Object.
@@ -232,19 +289,72 @@ func (g *GeometryCollection) Type() string {
 	return "GeometryCollection"
 }
 func (g *GeometryCollection) ToFeature(properties map[string]any) Feature {
-	return Feature{g, properties}
+	return Feature{Geometry: g, Properties: properties}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (g *GeometryCollection) MarshalJSON() ([]byte, error) {
+	var bbox *BoundingBox
+	if g.includeBBox {
+		bbox = g.BBox()
+	}
+	return json.Marshal(struct {
+		Type       string       `json:"type"`
+		BBox       *BoundingBox `json:"bbox,omitempty"`
+		Geometries []Geometry   `json:"geometries"`
+	}{
+		Type:       g.Type(),
+		BBox:       bbox,
+		Geometries: g.Geometries,
+	})
 }
 
 // Feature represents a [GeoJSON Feature](https://www.rfc-editor.org/rfc/rfc7946#section-3.2).
 type Feature struct {
 	Geometry   Geometry       `json:"geometry"`
 	Properties map[string]any `json:"properties"`
-	// ID string | float64 `json:"id"`
+	// ID is this feature's id. Per §3.2, it must be a JSON string or number (string, int, int64,
+	// or float64), or nil to omit the member entirely.
+	ID any `json:"id,omitempty"`
+	// ForeignMembers holds extra top-level members outside the GeoJSON spec, as permitted by
+	// [ยง6.1 Foreign Members](https://www.rfc-editor.org/rfc/rfc7946#section-6.1). Keys reserved by
+	// the spec ("type", "geometry", "properties", "features", "id", "bbox") are not allowed.
+	ForeignMembers map[string]any
+	includeBBox    bool
+	parsedBBox     *BoundingBox
+}
+
+// InvalidFeatureIDError is returned when a Feature's ID is not a string, int, int64, float64, or
+// nil, as required by [ยง3.2 Feature Object](https://www.rfc-editor.org/rfc/rfc7946#section-3.2).
+type InvalidFeatureIDError struct {
+	ID any
+}
+
+func (e *InvalidFeatureIDError) Error() string {
+	return fmt.Sprintf("geojson: feature id must be a string or number, got %T", e.ID)
+}
+
+func validateFeatureID(id any) error {
+	switch id.(type) {
+	case nil, string, int, int64, float64:
+		return nil
+	default:
+		return &InvalidFeatureIDError{ID: id}
+	}
 }
 
 // NewFeature creates a new Feature from the given Geometry and properties.
 func NewFeature(geometry Geometry, properties map[string]any) Feature {
-	return Feature{geometry, properties}
+	return Feature{Geometry: geometry, Properties: properties}
+}
+
+// NewFeatureWithID creates a new Feature from the given id, Geometry, and properties. id must be
+// a string, int, int64, float64, or nil.
+func NewFeatureWithID(id any, geometry Geometry, properties map[string]any) (Feature, error) {
+	if err := validateFeatureID(id); err != nil {
+		return Feature{}, err
+	}
+	return Feature{ID: id, Geometry: geometry, Properties: properties}, nil
 }
 
 // Type returns "Feature", implementing interface Object.
@@ -252,43 +362,135 @@ func (f Feature) Type() string {
 	return "Feature"
 }
 
-// MarshalJSON implements json.Marshaler.
-func (f *Feature) MarshalJSON() ([]byte, error) {
-	type Raw Feature
-	return json.Marshal(struct {
-		Type string `json:"type"`
-		*Raw
-	}{
-		Type: f.Type(),
-		Raw:  (*Raw)(f),
-	})
+// BBox computes the envelope of f.Geometry's coordinates, or nil if f has no geometry. If f was
+// unmarshaled from text that included a "bbox" member, that parsed value is returned instead.
+func (f Feature) BBox() *BoundingBox {
+	if f.parsedBBox != nil {
+		return f.parsedBBox
+	}
+	if f.Geometry == nil {
+		return nil
+	}
+	return f.Geometry.BBox()
+}
+
+// WithBBox returns a copy of f configured to include a computed "bbox" member when marshaled, as
+// described in [ยง5 Bounding Box](https://www.rfc-editor.org/rfc/rfc7946#section-5). It's a value
+// receiver, unlike the geometry types' WithBBox, so it chains off NewFeature and
+// NewFeatureWithID, which return Feature values rather than pointers.
+func (f Feature) WithBBox() Feature {
+	f.includeBBox = true
+	return f
+}
+
+// MarshalJSON implements json.Marshaler, merging ForeignMembers into the top-level object.
+func (f Feature) MarshalJSON() ([]byte, error) {
+	if err := validateFeatureID(f.ID); err != nil {
+		return nil, err
+	}
+	m, err := mergeForeignMembers(map[string]any{
+		"type":       f.Type(),
+		"geometry":   f.Geometry,
+		"properties": f.Properties,
+	}, f.ForeignMembers)
+	if err != nil {
+		return nil, err
+	}
+	if f.ID != nil {
+		m["id"] = f.ID
+	}
+	if f.includeBBox {
+		if bbox := f.BBox(); bbox != nil {
+			m["bbox"] = bbox
+		}
+	}
+	return json.Marshal(m)
+}
+
+// Validate checks f's geometry against the constraints of
+// [ยง3.1 Geometry Objects](https://www.rfc-editor.org/rfc/rfc7946#section-3.1), returning a
+// *ValidationError describing the first violation found, if any. A Feature with no geometry is
+// always valid.
+func (f Feature) Validate() error {
+	if f.Geometry == nil {
+		return nil
+	}
+	if err := f.Geometry.Validate(); err != nil {
+		if ve, ok := err.(*ValidationError); ok {
+			return &ValidationError{Path: "/geometry" + ve.Path, Reason: ve.Reason}
+		}
+		return err
+	}
+	return nil
 }
 
 // FeatureCollection represents a [GeoJSON FeatureCollection](https://www.rfc-editor.org/rfc/rfc7946#section-3.3).
-type FeatureCollection []Feature
+type FeatureCollection struct {
+	Features []Feature
+	// ForeignMembers holds extra top-level members outside the GeoJSON spec, as permitted by
+	// [ยง6.1 Foreign Members](https://www.rfc-editor.org/rfc/rfc7946#section-6.1). Keys reserved by
+	// the spec ("type", "geometry", "properties", "features", "id", "bbox") are not allowed.
+	ForeignMembers map[string]any
+	includeBBox    bool
+	parsedBBox     *BoundingBox
+}
 
 // NewFeatureCollection creates a new FeatureCollection from the given Features.
 func NewFeatureCollection(features ...Feature) FeatureCollection {
-	return features
+	return FeatureCollection{Features: features}
 }
 
 // Type returns "FeatureCollection", implementing interface Object.
-func (c *FeatureCollection) Type() string {
+func (c FeatureCollection) Type() string {
 	return "FeatureCollection"
 }
 
-// Type returns "FeatureCollection", implementing interface Object.
+// With returns a new FeatureCollection with features appended to c's.
 func (c FeatureCollection) With(features ...Feature) FeatureCollection {
-	return append(c, features...)
+	return FeatureCollection{
+		Features:       append(c.Features, features...),
+		ForeignMembers: c.ForeignMembers,
+		includeBBox:    c.includeBBox,
+	}
 }
 
-// MarshalJSON implements json.Marshaler.
-func (c *FeatureCollection) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
-		Type     string    `json:"type"`
-		Features []Feature `json:"features"`
-	}{
-		Type:     c.Type(),
-		Features: *c,
-	})
+// WithBBox returns a copy of c configured to include a computed "bbox" member when marshaled, as
+// described in [ยง5 Bounding Box](https://www.rfc-editor.org/rfc/rfc7946#section-5). It's a value
+// receiver, unlike the geometry types' WithBBox, so it chains off NewFeatureCollection, which
+// returns a FeatureCollection value rather than a pointer.
+func (c FeatureCollection) WithBBox() FeatureCollection {
+	c.includeBBox = true
+	return c
+}
+
+// MarshalJSON implements json.Marshaler, merging ForeignMembers into the top-level object.
+func (c FeatureCollection) MarshalJSON() ([]byte, error) {
+	m, err := mergeForeignMembers(map[string]any{
+		"type":     c.Type(),
+		"features": c.Features,
+	}, c.ForeignMembers)
+	if err != nil {
+		return nil, err
+	}
+	if c.includeBBox {
+		if bbox := c.BBox(); bbox != nil {
+			m["bbox"] = bbox
+		}
+	}
+	return json.Marshal(m)
+}
+
+// Validate checks every Feature in c against the constraints of
+// [ยง3.1 Geometry Objects](https://www.rfc-editor.org/rfc/rfc7946#section-3.1), returning a
+// *ValidationError describing the first violation found, if any.
+func (c FeatureCollection) Validate() error {
+	for i, f := range c.Features {
+		if err := f.Validate(); err != nil {
+			if ve, ok := err.(*ValidationError); ok {
+				return &ValidationError{Path: fmt.Sprintf("/features/%d%s", i, ve.Path), Reason: ve.Reason}
+			}
+			return err
+		}
+	}
+	return nil
 }
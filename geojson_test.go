@@ -0,0 +1,114 @@
+package geojson
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPositionRoundTrip(t *testing.T) {
+	cases := []Position{
+		NewPosition2D(1.5, -2.5),
+		NewPosition3D(1.5, -2.5, 100),
+	}
+	for _, p := range cases {
+		data, err := json.Marshal(p)
+		if err != nil {
+			t.Fatalf("marshal %#v: %v", p, err)
+		}
+		var got Position
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("unmarshal %s: %v", data, err)
+		}
+		if !got.Equal(p) {
+			t.Errorf("round trip %#v through %s got %#v", p, data, got)
+		}
+	}
+}
+
+func TestPositionUnmarshalInvalidShape(t *testing.T) {
+	var p Position
+	err := json.Unmarshal([]byte(`[1]`), &p)
+	if _, ok := err.(*CoordinatesError); !ok {
+		t.Fatalf("expected *CoordinatesError, got %v (%T)", err, err)
+	}
+}
+
+func TestGeometryRoundTrip(t *testing.T) {
+	geometries := []Geometry{
+		NewPoint(NewPosition2D(1, 2)),
+		NewMultiPoint(NewPosition2D(1, 2), NewPosition2D(3, 4)),
+		NewLineString(NewPosition2D(0, 0), NewPosition2D(1, 1)),
+		NewMultiLineString(
+			NewLineStringCoordinates(NewPosition2D(0, 0), NewPosition2D(1, 1)),
+			NewLineStringCoordinates(NewPosition2D(2, 2), NewPosition2D(3, 3)),
+		),
+		NewPolygon(NewLinearRing(NewPosition2D(0, 0), NewPosition2D(1, 0), NewPosition2D(1, 1), NewPosition2D(0, 0))),
+		NewMultiPolygon(NewPolygonCoordinates(
+			NewLinearRing(NewPosition2D(0, 0), NewPosition2D(1, 0), NewPosition2D(1, 1), NewPosition2D(0, 0)),
+		)),
+		NewGeometryCollection(NewPoint(NewPosition2D(5, 6))),
+	}
+	for _, g := range geometries {
+		data, err := ToText(g)
+		if err != nil {
+			t.Fatalf("marshal %T: %v", g, err)
+		}
+		got, err := UnmarshalGeometry(data)
+		if err != nil {
+			t.Fatalf("UnmarshalGeometry(%s): %v", data, err)
+		}
+		if got.Type() != g.Type() {
+			t.Errorf("round trip %s: got type %q, want %q", data, got.Type(), g.Type())
+		}
+	}
+}
+
+func TestUnmarshalGeometryTypeMismatch(t *testing.T) {
+	_, err := UnmarshalGeometry([]byte(`{"type":"NotAType","coordinates":[1,2]}`))
+	if _, ok := err.(*TypeMismatchError); !ok {
+		t.Fatalf("expected *TypeMismatchError, got %v (%T)", err, err)
+	}
+}
+
+func TestUnmarshalLinearRingUnclosed(t *testing.T) {
+	_, err := UnmarshalGeometry([]byte(`{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}`))
+	if _, ok := err.(*UnclosedRingError); !ok {
+		t.Fatalf("expected *UnclosedRingError, got %v (%T)", err, err)
+	}
+}
+
+func TestFeatureRoundTrip(t *testing.T) {
+	f := NewFeature(NewPoint(NewPosition2D(1, 2)), map[string]any{"name": "test"})
+	data, err := json.Marshal(f)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	got, err := UnmarshalFeature(data)
+	if err != nil {
+		t.Fatalf("UnmarshalFeature(%s): %v", data, err)
+	}
+	if got.Properties["name"] != "test" {
+		t.Errorf("got properties %#v, want name=test", got.Properties)
+	}
+	if got.Geometry.Type() != "Point" {
+		t.Errorf("got geometry type %q, want Point", got.Geometry.Type())
+	}
+}
+
+func TestFeatureCollectionRoundTrip(t *testing.T) {
+	fc := NewFeatureCollection(
+		NewFeature(NewPoint(NewPosition2D(1, 2)), nil),
+		NewFeature(NewLineString(NewPosition2D(0, 0), NewPosition2D(1, 1)), nil),
+	)
+	data, err := json.Marshal(fc)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	got, err := UnmarshalFeatureCollection(data)
+	if err != nil {
+		t.Fatalf("UnmarshalFeatureCollection(%s): %v", data, err)
+	}
+	if len(got.Features) != 2 {
+		t.Fatalf("got %d features, want 2", len(got.Features))
+	}
+}
@@ -0,0 +1,334 @@
+package geojson
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// TypeMismatchError is returned when a GeoJSON object's "type" member does not
+// match the concrete type performing the unmarshal, or does not name one of
+// the types enumerated in [ยง7 GeoJSON Types Are Not Extensible](https://www.rfc-editor.org/rfc/rfc7946#section-7).
+type TypeMismatchError struct {
+	// Expected is the type name the caller was unmarshaling into, or "" if any known type would do.
+	Expected string
+	// Actual is the type name found in the JSON.
+	Actual string
+}
+
+func (e *TypeMismatchError) Error() string {
+	if e.Expected == "" {
+		return fmt.Sprintf("geojson: unknown type %q", e.Actual)
+	}
+	return fmt.Sprintf("geojson: expected type %q, got %q", e.Expected, e.Actual)
+}
+
+// CoordinatesError is returned when a "coordinates" (or position) array does not have the shape
+// required by [ยง3.1 Geometry Objects](https://www.rfc-editor.org/rfc/rfc7946#section-3.1).
+type CoordinatesError struct {
+	// Type is the name of the GeoJSON type whose coordinates failed to parse.
+	Type string
+	// Reason describes what was wrong with the array.
+	Reason string
+}
+
+func (e *CoordinatesError) Error() string {
+	return fmt.Sprintf("geojson: invalid %s coordinates: %s", e.Type, e.Reason)
+}
+
+// UnclosedRingError is returned when a LinearRing's first and last positions don't match, as
+// required by [ยง3.1.6 Polygon](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.6).
+type UnclosedRingError struct {
+	First, Last Position
+}
+
+func (e *UnclosedRingError) Error() string {
+	return fmt.Sprintf("geojson: linear ring not closed: first position %v does not match last position %v", e.First, e.Last)
+}
+
+// UnmarshalFeatureCollection parses [GeoJSON FeatureCollection text](https://www.rfc-editor.org/rfc/rfc7946#section-3.3)
+// into a FeatureCollection.
+func UnmarshalFeatureCollection(data []byte) (FeatureCollection, error) {
+	var c FeatureCollection
+	if err := json.Unmarshal(data, &c); err != nil {
+		return FeatureCollection{}, err
+	}
+	return c, nil
+}
+
+// UnmarshalFeature parses [GeoJSON Feature text](https://www.rfc-editor.org/rfc/rfc7946#section-3.2)
+// into a Feature.
+func UnmarshalFeature(data []byte) (Feature, error) {
+	var f Feature
+	if err := json.Unmarshal(data, &f); err != nil {
+		return Feature{}, err
+	}
+	return f, nil
+}
+
+// UnmarshalGeometry parses [GeoJSON Geometry text](https://www.rfc-editor.org/rfc/rfc7946#section-3.1)
+// into a Geometry, dispatching on the "type" member to the concrete geometry type's own
+// coordinate decoder.
+func UnmarshalGeometry(data []byte) (Geometry, error) {
+	var typed struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return nil, err
+	}
+	var g Geometry
+	switch typed.Type {
+	case "Point":
+		g = &Point{}
+	case "MultiPoint":
+		g = &MultiPoint{}
+	case "LineString":
+		g = &LineString{}
+	case "MultiLineString":
+		g = &MultiLineString{}
+	case "Polygon":
+		g = &Polygon{}
+	case "MultiPolygon":
+		g = &MultiPolygon{}
+	case "GeometryCollection":
+		g = &GeometryCollection{}
+	default:
+		return nil, &TypeMismatchError{Actual: typed.Type}
+	}
+	if err := json.Unmarshal(data, g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting both the 2-element `[lon,lat]` and
+// 3-element `[lon,lat,alt]` forms described in [ยง3.1.1 Position](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.1).
+func (p *Position) UnmarshalJSON(data []byte) error {
+	var raw []float64
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) < 2 || len(raw) > 3 {
+		return &CoordinatesError{Type: "Position", Reason: fmt.Sprintf("expected 2 or 3 elements, got %d", len(raw))}
+	}
+	p.Longitude = raw[0]
+	p.Latitude = raw[1]
+	if len(raw) == 3 {
+		p.Altitude = &raw[2]
+	} else {
+		p.Altitude = nil
+	}
+	return nil
+}
+
+// unmarshalGeoJSONGeometryWithCoordinates decodes a GeoJSON geometry object of the given type
+// into coordinates, checking that the "type" member matches g.Type(), and recording any "bbox"
+// member it finds so BBox() can return it verbatim.
+func unmarshalGeoJSONGeometryWithCoordinates[C any](g Geometry, data []byte, coordinates *C, parsedBBox **BoundingBox, includeBBox *bool) error {
+	var raw struct {
+		Type        string       `json:"type"`
+		BBox        *BoundingBox `json:"bbox"`
+		Coordinates C            `json:"coordinates"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Type != g.Type() {
+		return &TypeMismatchError{Expected: g.Type(), Actual: raw.Type}
+	}
+	*coordinates = raw.Coordinates
+	*parsedBBox = raw.BBox
+	*includeBBox = raw.BBox != nil
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *Point) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSONGeometryWithCoordinates(g, data, &g.Coordinates, &g.parsedBBox, &g.includeBBox)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *MultiPoint) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSONGeometryWithCoordinates(g, data, &g.Coordinates, &g.parsedBBox, &g.includeBBox)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *LineString) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSONGeometryWithCoordinates(g, data, &g.Coordinates, &g.parsedBBox, &g.includeBBox)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *MultiLineString) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSONGeometryWithCoordinates(g, data, &g.Coordinates, &g.parsedBBox, &g.includeBBox)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *Polygon) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSONGeometryWithCoordinates(g, data, &g.Coordinates, &g.parsedBBox, &g.includeBBox)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *MultiPolygon) UnmarshalJSON(data []byte) error {
+	return unmarshalGeoJSONGeometryWithCoordinates(g, data, &g.Coordinates, &g.parsedBBox, &g.includeBBox)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, rejecting rings that don't close (the first
+// position must equal the last) as required by [ยง3.1.6 Polygon](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.6).
+func (r *LinearRing) UnmarshalJSON(data []byte) error {
+	var positions []Position
+	if err := json.Unmarshal(data, &positions); err != nil {
+		return err
+	}
+	if len(positions) < 4 {
+		return &CoordinatesError{Type: "LinearRing", Reason: fmt.Sprintf("expected at least 4 positions, got %d", len(positions))}
+	}
+	if !positions[0].Equal(positions[len(positions)-1]) {
+		return &UnclosedRingError{First: positions[0], Last: positions[len(positions)-1]}
+	}
+	*r = positions
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding each element of "geometries" with
+// UnmarshalGeometry since Geometry is an interface.
+func (g *GeometryCollection) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Type       string            `json:"type"`
+		BBox       *BoundingBox      `json:"bbox"`
+		Geometries []json.RawMessage `json:"geometries"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Type != g.Type() {
+		return &TypeMismatchError{Expected: g.Type(), Actual: raw.Type}
+	}
+	geometries := make([]Geometry, len(raw.Geometries))
+	for i, rawGeometry := range raw.Geometries {
+		geometry, err := UnmarshalGeometry(rawGeometry)
+		if err != nil {
+			return err
+		}
+		geometries[i] = geometry
+	}
+	g.Geometries = geometries
+	g.parsedBBox = raw.BBox
+	g.includeBBox = raw.BBox != nil
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding Geometry with UnmarshalGeometry since
+// Feature.Geometry is an interface, and collecting any members beyond the ones GeoJSON assigns
+// meaning to into ForeignMembers.
+func (f *Feature) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	typeRaw, ok := raw["type"]
+	if !ok {
+		return &TypeMismatchError{Expected: f.Type()}
+	}
+	var typ string
+	if err := json.Unmarshal(typeRaw, &typ); err != nil {
+		return err
+	}
+	if typ != f.Type() {
+		return &TypeMismatchError{Expected: f.Type(), Actual: typ}
+	}
+	var geometry Geometry
+	if geometryRaw, ok := raw["geometry"]; ok && string(geometryRaw) != "null" {
+		g, err := UnmarshalGeometry(geometryRaw)
+		if err != nil {
+			return err
+		}
+		geometry = g
+	}
+	var properties map[string]any
+	if propertiesRaw, ok := raw["properties"]; ok {
+		if err := json.Unmarshal(propertiesRaw, &properties); err != nil {
+			return err
+		}
+	}
+	id, err := unmarshalFeatureID(raw["id"])
+	if err != nil {
+		return err
+	}
+	var bbox *BoundingBox
+	if bboxRaw, ok := raw["bbox"]; ok {
+		bbox = &BoundingBox{}
+		if err := json.Unmarshal(bboxRaw, bbox); err != nil {
+			return err
+		}
+	}
+	foreignMembers, err := extractForeignMembers(raw)
+	if err != nil {
+		return err
+	}
+	f.Geometry = geometry
+	f.Properties = properties
+	f.ID = id
+	f.ForeignMembers = foreignMembers
+	f.parsedBBox = bbox
+	f.includeBBox = bbox != nil
+	return nil
+}
+
+// unmarshalFeatureID decodes a "id" member, preserving the JSON string/number distinction: string
+// ids stay string, numeric ids decode as float64. Returns nil if raw is empty or "null" (the
+// member was absent or explicitly null).
+func unmarshalFeatureID(raw json.RawMessage) (any, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, nil
+	}
+	var n float64
+	if err := json.Unmarshal(raw, &n); err != nil {
+		return nil, fmt.Errorf("geojson: feature id must be a string or number: %w", err)
+	}
+	return n, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, collecting any members beyond the ones GeoJSON
+// assigns meaning to into ForeignMembers.
+func (c *FeatureCollection) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	typeRaw, ok := raw["type"]
+	if !ok {
+		return &TypeMismatchError{Expected: c.Type()}
+	}
+	var typ string
+	if err := json.Unmarshal(typeRaw, &typ); err != nil {
+		return err
+	}
+	if typ != c.Type() {
+		return &TypeMismatchError{Expected: c.Type(), Actual: typ}
+	}
+	var features []Feature
+	if featuresRaw, ok := raw["features"]; ok {
+		if err := json.Unmarshal(featuresRaw, &features); err != nil {
+			return err
+		}
+	}
+	var bbox *BoundingBox
+	if bboxRaw, ok := raw["bbox"]; ok {
+		bbox = &BoundingBox{}
+		if err := json.Unmarshal(bboxRaw, bbox); err != nil {
+			return err
+		}
+	}
+	foreignMembers, err := extractForeignMembers(raw)
+	if err != nil {
+		return err
+	}
+	c.Features = features
+	c.ForeignMembers = foreignMembers
+	c.parsedBBox = bbox
+	c.includeBBox = bbox != nil
+	return nil
+}
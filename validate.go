@@ -0,0 +1,316 @@
+package geojson
+
+import "fmt"
+
+// ValidationError is returned by Validate when an Object violates a constraint of
+// [ยง3.1 Geometry Objects](https://www.rfc-editor.org/rfc/rfc7946#section-3.1). Path identifies the
+// offending value as a JSON pointer relative to the validated object, e.g.
+// "/features/3/geometry/coordinates/0/2".
+type ValidationError struct {
+	Path   string
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("geojson: invalid value at %s: %s", e.Path, e.Reason)
+}
+
+// validatePosition checks p against the coordinate ranges implied by
+// [ยง4 Coordinate Reference System](https://www.rfc-editor.org/rfc/rfc7946#section-4).
+func validatePosition(p Position, path string) error {
+	if p.Longitude < -180 || p.Longitude > 180 {
+		return &ValidationError{Path: path, Reason: fmt.Sprintf("longitude %g is outside [-180, 180]", p.Longitude)}
+	}
+	if p.Latitude < -90 || p.Latitude > 90 {
+		return &ValidationError{Path: path, Reason: fmt.Sprintf("latitude %g is outside [-90, 90]", p.Latitude)}
+	}
+	return nil
+}
+
+// validateLineStringCoordinates checks positions against the requirement in
+// [ยง3.1.4](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.4) that a LineString have at least
+// two distinct positions.
+func validateLineStringCoordinates(positions []Position, path string) error {
+	if len(positions) < 2 {
+		return &ValidationError{Path: path, Reason: fmt.Sprintf("expected at least 2 positions, got %d", len(positions))}
+	}
+	distinct := false
+	for i, p := range positions {
+		if err := validatePosition(p, fmt.Sprintf("%s/%d", path, i)); err != nil {
+			return err
+		}
+		if !p.Equal(positions[0]) {
+			distinct = true
+		}
+	}
+	if !distinct {
+		return &ValidationError{Path: path, Reason: "positions are all identical"}
+	}
+	return nil
+}
+
+// validateLinearRing checks ring against [ยง3.1.6](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.6):
+// at least four positions, closed, and not self-intersecting.
+func validateLinearRing(ring LinearRing, path string) error {
+	if len(ring) < 4 {
+		return &ValidationError{Path: path, Reason: fmt.Sprintf("expected at least 4 positions, got %d", len(ring))}
+	}
+	for i, p := range ring {
+		if err := validatePosition(p, fmt.Sprintf("%s/%d", path, i)); err != nil {
+			return err
+		}
+	}
+	if !ring[0].Equal(ring[len(ring)-1]) {
+		return &ValidationError{Path: path, Reason: "ring is not closed"}
+	}
+	if ringSelfIntersects(ring) {
+		return &ValidationError{Path: path, Reason: "ring self-intersects"}
+	}
+	return nil
+}
+
+// validatePolygonCoordinates checks rings against [ยง3.1.6](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.6):
+// a closed, non-self-intersecting exterior ring wound counter-clockwise, followed by any holes
+// wound clockwise per the right-hand rule, with no ring crossing another.
+func validatePolygonCoordinates(rings PolygonCoordinates, path string) error {
+	if len(rings) == 0 {
+		return &ValidationError{Path: path, Reason: "polygon has no exterior ring"}
+	}
+	for i, ring := range rings {
+		ringPath := fmt.Sprintf("%s/%d", path, i)
+		if err := validateLinearRing(ring, ringPath); err != nil {
+			return err
+		}
+		switch area := signedArea(ring); {
+		case i == 0 && area <= 0:
+			return &ValidationError{Path: ringPath, Reason: "exterior ring must be wound counter-clockwise"}
+		case i > 0 && area >= 0:
+			return &ValidationError{Path: ringPath, Reason: "hole must be wound clockwise"}
+		}
+	}
+	for i := 0; i < len(rings); i++ {
+		for j := i + 1; j < len(rings); j++ {
+			if ringsIntersect(rings[i], rings[j]) {
+				return &ValidationError{
+					Path:   fmt.Sprintf("%s/%d", path, j),
+					Reason: fmt.Sprintf("crosses the ring at %s/%d", path, i),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ringsIntersect reports whether any edge of a crosses any edge of b. Rings that merely touch at
+// a shared vertex or along a collinear edge are not considered crossing.
+func ringsIntersect(a, b LinearRing) bool {
+	na, nb := len(a)-1, len(b)-1 // a[na] and b[nb] duplicate a[0] and b[0]
+	for i := 0; i < na; i++ {
+		for j := 0; j < nb; j++ {
+			if segmentsIntersect(a[i], a[i+1], b[j], b[j+1]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// polygonsOverlap reports whether a and b, assumed individually valid, share interior area: either
+// their exterior rings cross, or one polygon's exterior ring has a vertex strictly inside the
+// other. Polygons that merely touch along an edge or at a point are not flagged.
+func polygonsOverlap(a, b PolygonCoordinates) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+	if ringsIntersect(a[0], b[0]) {
+		return true
+	}
+	for _, p := range a[0][:len(a[0])-1] {
+		if pointInPolygon(p, b) {
+			return true
+		}
+	}
+	for _, p := range b[0][:len(b[0])-1] {
+		if pointInPolygon(p, a) {
+			return true
+		}
+	}
+	return false
+}
+
+// pointInPolygon reports whether p lies strictly inside poly's exterior ring and outside every
+// hole, treating points on a ring's boundary as not inside so that polygons touching only along a
+// shared edge or vertex aren't reported as overlapping.
+func pointInPolygon(p Position, poly PolygonCoordinates) bool {
+	if len(poly) == 0 || pointOnRing(p, poly[0]) || !pointInRing(p, poly[0]) {
+		return false
+	}
+	for _, hole := range poly[1:] {
+		if pointOnRing(p, hole) || pointInRing(p, hole) {
+			return false
+		}
+	}
+	return true
+}
+
+// pointOnRing reports whether p lies on one of ring's edges.
+func pointOnRing(p Position, ring LinearRing) bool {
+	for i := 0; i < len(ring)-1; i++ {
+		if pointOnSegment(p, ring[i], ring[i+1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// pointOnSegment reports whether p lies on the closed segment ab.
+func pointOnSegment(p, a, b Position) bool {
+	if direction(a, b, p) != 0 {
+		return false
+	}
+	lonLo, lonHi := a.Longitude, b.Longitude
+	if lonLo > lonHi {
+		lonLo, lonHi = lonHi, lonLo
+	}
+	latLo, latHi := a.Latitude, b.Latitude
+	if latLo > latHi {
+		latLo, latHi = latHi, latLo
+	}
+	return p.Longitude >= lonLo && p.Longitude <= lonHi && p.Latitude >= latLo && p.Latitude <= latHi
+}
+
+// pointInRing reports whether p lies inside ring via the standard even-odd ray casting test.
+func pointInRing(p Position, ring LinearRing) bool {
+	inside := false
+	n := len(ring) - 1 // ring[n] duplicates ring[0]
+	for i, j := 0, n-1; i < n; j, i = i, i+1 {
+		pi, pj := ring[i], ring[j]
+		if (pi.Latitude > p.Latitude) != (pj.Latitude > p.Latitude) &&
+			p.Longitude < (pj.Longitude-pi.Longitude)*(p.Latitude-pi.Latitude)/(pj.Latitude-pi.Latitude)+pi.Longitude {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// signedArea computes twice the shoelace area of ring divided by two; the sign indicates winding
+// order (positive is counter-clockwise) per the right-hand rule of
+// [ยง3.1.6](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.6).
+func signedArea(ring LinearRing) float64 {
+	var area float64
+	n := len(ring)
+	for i := 0; i < n; i++ {
+		j := (i + 1) % n
+		area += ring[i].Longitude*ring[j].Latitude - ring[j].Longitude*ring[i].Latitude
+	}
+	return area / 2
+}
+
+// ringSelfIntersects reports whether any two non-adjacent edges of ring cross.
+func ringSelfIntersects(ring LinearRing) bool {
+	n := len(ring) - 1 // ring[n] duplicates ring[0]
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if j == i+1 || (i == 0 && j == n-1) {
+				continue // adjacent edges share a vertex
+			}
+			if segmentsIntersect(ring[i], ring[i+1], ring[j], ring[(j+1)%n]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// segmentsIntersect reports whether segments p1p2 and p3p4 cross at a point interior to both,
+// using the standard orientation test.
+func segmentsIntersect(p1, p2, p3, p4 Position) bool {
+	d1 := direction(p3, p4, p1)
+	d2 := direction(p3, p4, p2)
+	d3 := direction(p1, p2, p3)
+	d4 := direction(p1, p2, p4)
+	return ((d1 > 0) != (d2 > 0)) && (d1 != 0 && d2 != 0) &&
+		((d3 > 0) != (d4 > 0)) && (d3 != 0 && d4 != 0)
+}
+
+// direction returns the cross product of (b-a) and (c-a), whose sign indicates which side of line
+// ab point c falls on.
+func direction(a, b, c Position) float64 {
+	return (c.Longitude-a.Longitude)*(b.Latitude-a.Latitude) - (b.Longitude-a.Longitude)*(c.Latitude-a.Latitude)
+}
+
+// Validate checks g against [ยง3.1.2](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.2):
+// its position's coordinates are in range.
+func (g *Point) Validate() error {
+	return validatePosition(g.Coordinates, "/coordinates")
+}
+
+// Validate checks g against [ยง3.1.3](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.3):
+// every position's coordinates are in range.
+func (g *MultiPoint) Validate() error {
+	for i, p := range g.Coordinates {
+		if err := validatePosition(p, fmt.Sprintf("/coordinates/%d", i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks g against [ยง3.1.4](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.4):
+// at least two distinct positions, all with in-range coordinates.
+func (g *LineString) Validate() error {
+	return validateLineStringCoordinates(g.Coordinates, "/coordinates")
+}
+
+// Validate checks g against [ยง3.1.5](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.5):
+// every line has at least two distinct positions, all with in-range coordinates.
+func (g *MultiLineString) Validate() error {
+	for i, line := range g.Coordinates {
+		if err := validateLineStringCoordinates(line, fmt.Sprintf("/coordinates/%d", i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks g against [ยง3.1.6](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.6): a
+// closed, non-self-intersecting exterior ring wound counter-clockwise, followed by any holes
+// wound clockwise.
+func (g *Polygon) Validate() error {
+	return validatePolygonCoordinates(g.Coordinates, "/coordinates")
+}
+
+// Validate checks g against [ยง3.1.7](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.7): every
+// polygon is independently valid, and no two polygons overlap other than along their boundaries.
+func (g *MultiPolygon) Validate() error {
+	for i, polygon := range g.Coordinates {
+		if err := validatePolygonCoordinates(polygon, fmt.Sprintf("/coordinates/%d", i)); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < len(g.Coordinates); i++ {
+		for j := i + 1; j < len(g.Coordinates); j++ {
+			if polygonsOverlap(g.Coordinates[i], g.Coordinates[j]) {
+				return &ValidationError{
+					Path:   fmt.Sprintf("/coordinates/%d", j),
+					Reason: fmt.Sprintf("overlaps the polygon at /coordinates/%d", i),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks every element of g.Geometries, recursing into nested GeometryCollections, as
+// required by [ยง3.1.8](https://www.rfc-editor.org/rfc/rfc7946#section-3.1.8).
+func (g *GeometryCollection) Validate() error {
+	for i, geometry := range g.Geometries {
+		if err := geometry.Validate(); err != nil {
+			if ve, ok := err.(*ValidationError); ok {
+				return &ValidationError{Path: fmt.Sprintf("/geometries/%d%s", i, ve.Path), Reason: ve.Reason}
+			}
+			return err
+		}
+	}
+	return nil
+}
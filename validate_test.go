@@ -0,0 +1,118 @@
+package geojson
+
+import "testing"
+
+func TestValidatePositionRange(t *testing.T) {
+	if err := NewPoint(NewPosition2D(181, 0)).Validate(); err == nil {
+		t.Fatal("expected out-of-range longitude to fail")
+	}
+	if err := NewPoint(NewPosition2D(10, 91)).Validate(); err == nil {
+		t.Fatal("expected out-of-range latitude to fail")
+	}
+	if err := NewPoint(NewPosition2D(10, 20)).Validate(); err != nil {
+		t.Fatalf("valid point failed: %v", err)
+	}
+}
+
+func TestValidateLineStringNeedsDistinctPositions(t *testing.T) {
+	if err := NewLineString(NewPosition2D(0, 0), NewPosition2D(0, 0)).Validate(); err == nil {
+		t.Fatal("expected identical-position LineString to fail")
+	}
+}
+
+func TestValidatePolygonWinding(t *testing.T) {
+	ccw := NewLinearRing(NewPosition2D(0, 0), NewPosition2D(4, 0), NewPosition2D(4, 4), NewPosition2D(0, 4), NewPosition2D(0, 0))
+	if err := NewPolygon(ccw).Validate(); err != nil {
+		t.Fatalf("expected valid CCW exterior ring, got: %v", err)
+	}
+
+	cw := NewLinearRing(NewPosition2D(0, 0), NewPosition2D(0, 4), NewPosition2D(4, 4), NewPosition2D(4, 0), NewPosition2D(0, 0))
+	if err := NewPolygon(cw).Validate(); err == nil {
+		t.Fatal("expected CW exterior ring to fail")
+	}
+
+	ccwHole := NewLinearRing(NewPosition2D(1, 1), NewPosition2D(2, 1), NewPosition2D(2, 2), NewPosition2D(1, 2), NewPosition2D(1, 1))
+	if err := NewPolygon(ccw, ccwHole).Validate(); err == nil {
+		t.Fatal("expected CCW-wound hole to fail")
+	}
+}
+
+func TestValidatePolygonSelfIntersection(t *testing.T) {
+	bowtie := NewLinearRing(NewPosition2D(0, 0), NewPosition2D(4, 4), NewPosition2D(4, 0), NewPosition2D(0, 4), NewPosition2D(0, 0))
+	if err := NewPolygon(bowtie).Validate(); err == nil {
+		t.Fatal("expected self-intersecting ring to fail")
+	}
+}
+
+func TestValidatePolygonHoleCrossesExteriorOrHole(t *testing.T) {
+	exterior := NewLinearRing(NewPosition2D(0, 0), NewPosition2D(10, 0), NewPosition2D(10, 10), NewPosition2D(0, 10), NewPosition2D(0, 0))
+
+	crossingExterior := NewLinearRing(NewPosition2D(8, 8), NewPosition2D(15, 8), NewPosition2D(15, 2), NewPosition2D(8, 2), NewPosition2D(8, 8))
+	if err := NewPolygon(exterior, crossingExterior).Validate(); err == nil {
+		t.Fatal("expected hole crossing the exterior ring to fail")
+	}
+
+	holeA := NewLinearRing(NewPosition2D(2, 2), NewPosition2D(2, 6), NewPosition2D(6, 6), NewPosition2D(6, 2), NewPosition2D(2, 2))
+	holeB := NewLinearRing(NewPosition2D(4, 4), NewPosition2D(4, 8), NewPosition2D(8, 8), NewPosition2D(8, 4), NewPosition2D(4, 4))
+	if err := NewPolygon(exterior, holeA, holeB).Validate(); err == nil {
+		t.Fatal("expected crossing holes to fail")
+	}
+
+	wellFormedHole := NewLinearRing(NewPosition2D(2, 2), NewPosition2D(2, 4), NewPosition2D(4, 4), NewPosition2D(4, 2), NewPosition2D(2, 2))
+	if err := NewPolygon(exterior, wellFormedHole).Validate(); err != nil {
+		t.Fatalf("expected valid polygon with non-crossing hole to pass, got: %v", err)
+	}
+}
+
+func TestValidateMultiPolygonOverlap(t *testing.T) {
+	horizontal := NewPolygonCoordinates(NewLinearRing(
+		NewPosition2D(0, 4), NewPosition2D(10, 4), NewPosition2D(10, 6), NewPosition2D(0, 6), NewPosition2D(0, 4),
+	))
+	vertical := NewPolygonCoordinates(NewLinearRing(
+		NewPosition2D(4, 0), NewPosition2D(6, 0), NewPosition2D(6, 10), NewPosition2D(4, 10), NewPosition2D(4, 0),
+	))
+	if err := NewMultiPolygon(horizontal, vertical).Validate(); err == nil {
+		t.Fatal("expected interlocking plus-shape polygons (no vertex inside either) to fail")
+	}
+
+	a := NewPolygonCoordinates(NewLinearRing(NewPosition2D(0, 0), NewPosition2D(4, 0), NewPosition2D(4, 4), NewPosition2D(0, 4), NewPosition2D(0, 0)))
+	b := NewPolygonCoordinates(NewLinearRing(NewPosition2D(4, 0), NewPosition2D(8, 0), NewPosition2D(8, 4), NewPosition2D(4, 4), NewPosition2D(4, 0)))
+	if err := NewMultiPolygon(a, b).Validate(); err != nil {
+		t.Fatalf("expected edge-touching-only polygons to pass, got: %v", err)
+	}
+}
+
+func TestValidateGeometryCollectionRecurses(t *testing.T) {
+	gc := NewGeometryCollection(NewPoint(NewPosition2D(200, 0)))
+	ve, ok := gc.Validate().(*ValidationError)
+	if !ok || ve.Path != "/geometries/0/coordinates" {
+		t.Fatalf("expected /geometries/0/coordinates path, got %#v", gc.Validate())
+	}
+}
+
+func TestValidateFeatureAndFeatureCollectionPaths(t *testing.T) {
+	f := NewFeature(NewPoint(NewPosition2D(200, 0)), nil)
+	ve, ok := f.Validate().(*ValidationError)
+	if !ok || ve.Path != "/geometry/coordinates" {
+		t.Fatalf("expected /geometry/coordinates path, got %#v", f.Validate())
+	}
+
+	fc := NewFeatureCollection(NewFeature(nil, nil), f)
+	ve2, ok := fc.Validate().(*ValidationError)
+	if !ok || ve2.Path != "/features/1/geometry/coordinates" {
+		t.Fatalf("expected /features/1/geometry/coordinates path, got %#v", fc.Validate())
+	}
+}
+
+func TestNewLinearRingE(t *testing.T) {
+	if _, err := NewLinearRingE(NewPosition2D(0, 0), NewPosition2D(1, 0), NewPosition2D(1, 1), NewPosition2D(5, 5)); err == nil {
+		t.Fatal("expected unclosed ring to fail")
+	}
+	ring, err := NewLinearRingE(NewPosition2D(0, 0), NewPosition2D(1, 0), NewPosition2D(1, 1), NewPosition2D(0, 0))
+	if err != nil {
+		t.Fatalf("expected closed ring to succeed, got: %v", err)
+	}
+	if len(ring) != 4 {
+		t.Fatalf("expected 4 positions, got %d", len(ring))
+	}
+}